@@ -1,6 +1,9 @@
 package dbadapter
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"io"
 
 	dbm "github.com/tendermint/tendermint/libs/db"
@@ -11,6 +14,39 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/trace"
 )
 
+// BackendOptions carries backend-specific tuning knobs through to a
+// registered BackendFactory's open function (e.g. cache sizes, compaction
+// settings). Backends that don't recognize a key should ignore it.
+type BackendOptions map[string]interface{}
+
+// BackendFactory opens a dbm.DB of a particular kind rooted at dir/name.
+type BackendFactory func(dir, name string, opts BackendOptions) (dbm.DB, error)
+
+var backends = make(map[string]BackendFactory)
+
+// RegisterBackend makes a backend (e.g. "badger", "pebble", "rocksdb",
+// "memdb") available under name, so NewStoreWithBackend can open it without
+// the store package needing to import the backend's driver directly.
+func RegisterBackend(name string, open BackendFactory) {
+	backends[name] = open
+}
+
+// NewStoreWithBackend opens a Store backed by the backend registered under
+// name.
+func NewStoreWithBackend(name, dir, dbName string, opts BackendOptions) (Store, error) {
+	open, ok := backends[name]
+	if !ok {
+		return Store{}, fmt.Errorf("dbadapter: no backend registered under %q", name)
+	}
+
+	db, err := open(dir, dbName, opts)
+	if err != nil {
+		return Store{}, err
+	}
+
+	return Store{DB: db}, nil
+}
+
 // Wrapper type for dbm.Db with implementation of KVStore
 type Store struct {
 	dbm.DB
@@ -31,17 +67,247 @@ func (dsa Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.Ca
 	return cache.NewStore(trace.NewStore(dsa, w, tc))
 }
 
-// XXX: delete
-/*
-// Implements KVStore
-func (dsa Store) Prefix(prefix []byte) KVStore {
-	return prefixStore{dsa, prefix}
+// CacheWrapWithTracingOptions is like CacheWrapWithTrace, but renders every
+// traced operation as a single line of structured JSON — operation, the
+// key/value in hex, and the block height pulled out of opts.Context — rather
+// than relying on trace.Store's default opaque format.
+func (dsa Store) CacheWrapWithTracingOptions(opts TracingOptions) types.CacheWrap {
+	return cache.NewStore(tracingStore{Store: dsa, opts: opts})
+}
+
+// TracingOptions configures the structured trace output produced by
+// CacheWrapWithTracingOptions.
+type TracingOptions struct {
+	// Writer receives one JSON-encoded line per traced Get/Set/Delete/Has
+	// call.
+	Writer io.Writer
+	// Context carries arbitrary metadata threaded onto every trace
+	// operation; the "blockHeight" entry, if present and an int64, is copied
+	// onto each line. Values of any other type are left out.
+	Context types.TraceContext
+}
+
+// traceOperation is a single structured trace line.
+type traceOperation struct {
+	Operation   string `json:"operation"`
+	Key         string `json:"key_hex"`
+	Value       string `json:"value_hex,omitempty"`
+	BlockHeight int64  `json:"block_height,omitempty"`
+}
+
+// tracingStore wraps a Store, writing a structured JSON traceOperation line
+// to opts.Writer for every Get/Set/Has/Delete call before delegating to the
+// underlying DB.
+type tracingStore struct {
+	Store
+	opts TracingOptions
+}
+
+func (ts tracingStore) emit(op string, key, value []byte) {
+	line := traceOperation{
+		Operation: op,
+		Key:       hex.EncodeToString(key),
+	}
+
+	if value != nil {
+		line.Value = hex.EncodeToString(value)
+	}
+
+	if height, ok := ts.opts.Context["blockHeight"]; ok {
+		if h, ok := height.(int64); ok {
+			line.BlockHeight = h
+		}
+	}
+
+	bz, err := json.Marshal(line)
+	if err != nil {
+		return
+	}
+
+	bz = append(bz, '\n')
+	_, _ = ts.opts.Writer.Write(bz)
+}
+
+func (ts tracingStore) Get(key []byte) []byte {
+	value := ts.Store.Get(key)
+	ts.emit("read", key, value)
+	return value
+}
+
+func (ts tracingStore) Has(key []byte) bool {
+	exists := ts.Store.Has(key)
+	ts.emit("has", key, nil)
+	return exists
+}
+
+func (ts tracingStore) Set(key, value []byte) {
+	ts.emit("write", key, value)
+	ts.Store.Set(key, value)
+}
+
+func (ts tracingStore) Delete(key []byte) {
+	ts.emit("delete", key, nil)
+	ts.Store.Delete(key)
+}
+
+// Batch exposes atomic batch write semantics for a Store: writes are
+// buffered in-memory and only reach the backing DB when Write is called.
+type Batch struct {
+	Store
+	batch dbm.Batch
+}
+
+// WithBatchWriter returns a Batch that buffers Set/Delete calls made through
+// it and commits them to the backing DB atomically on Write. Reads continue
+// to go straight to the backing DB and do not see buffered writes until
+// Write is called.
+func (dsa Store) WithBatchWriter() Batch {
+	return Batch{Store: dsa, batch: dsa.DB.NewBatch()}
+}
+
+// Set implements types.KVStore by buffering the write into the batch.
+func (b Batch) Set(key, value []byte) {
+	b.batch.Set(key, value)
 }
 
-// Implements KVStore
-func (dsa Store) Gas(meter GasMeter, config GasConfig) KVStore {
-	return NewGasKVStore(meter, config, dsa)
+// Delete implements types.KVStore by buffering the deletion into the batch.
+func (b Batch) Delete(key []byte) {
+	b.batch.Delete(key)
 }
-*/
+
+// Write atomically commits every Set/Delete call buffered so far to the
+// backing DB and releases the batch's resources. The Batch must not be
+// reused afterwards.
+func (b Batch) Write() error {
+	defer b.batch.Close()
+	return b.batch.Write()
+}
+
+// IteratorOptions configures PrefixIterator.
+type IteratorOptions struct {
+	// Reverse iterates from the end of the prefix range to its start.
+	Reverse bool
+	// ReadAhead is the number of key/value pairs to buffer ahead of the
+	// caller via a background goroutine. Zero or negative disables
+	// read-ahead and returns the backing DB's iterator directly.
+	ReadAhead int
+}
+
+// PrefixIterator returns an iterator over every key sharing prefix,
+// honoring opts.Reverse and opts.ReadAhead.
+func (dsa Store) PrefixIterator(prefix []byte, opts IteratorOptions) dbm.Iterator {
+	start, end := prefixRange(prefix)
+
+	var it dbm.Iterator
+	if opts.Reverse {
+		it = dsa.DB.ReverseIterator(start, end)
+	} else {
+		it = dsa.DB.Iterator(start, end)
+	}
+
+	if opts.ReadAhead <= 0 {
+		return it
+	}
+
+	return newReadAheadIterator(it, opts.ReadAhead)
+}
+
+// prefixRange returns the [start, end) key range covering every key with the
+// given prefix.
+func prefixRange(prefix []byte) (start, end []byte) {
+	start = prefix
+
+	end = make([]byte, len(prefix))
+	copy(end, prefix)
+
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return start, end[:i+1]
+		}
+	}
+
+	// prefix is all 0xff bytes; there is no upper bound.
+	return start, nil
+}
+
+type kvPair struct {
+	key, value []byte
+}
+
+// readAheadIterator wraps a dbm.Iterator, prefetching up to readAhead
+// key/value pairs from it on a background goroutine.
+type readAheadIterator struct {
+	dbm.Iterator
+
+	buf     chan kvPair
+	done    chan struct{}
+	stopped chan struct{}
+	cur     kvPair
+	valid   bool
+}
+
+func newReadAheadIterator(it dbm.Iterator, readAhead int) *readAheadIterator {
+	r := &readAheadIterator{
+		Iterator: it,
+		buf:      make(chan kvPair, readAhead),
+		done:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+
+	go r.fill()
+	r.Next()
+
+	return r
+}
+
+// fill prefetches key/value pairs from the wrapped iterator until it is
+// exhausted or done is closed by Close, signaling stopped once it will make
+// no further calls against the wrapped iterator.
+func (r *readAheadIterator) fill() {
+	defer close(r.stopped)
+	defer close(r.buf)
+
+	for r.Iterator.Valid() {
+		pair := kvPair{
+			key:   append([]byte(nil), r.Iterator.Key()...),
+			value: append([]byte(nil), r.Iterator.Value()...),
+		}
+
+		select {
+		case r.buf <- pair:
+			r.Iterator.Next()
+		case <-r.done:
+			return
+		}
+	}
+}
+
+// Valid implements dbm.Iterator.
+func (r *readAheadIterator) Valid() bool { return r.valid }
+
+// Next implements dbm.Iterator.
+func (r *readAheadIterator) Next() {
+	pair, ok := <-r.buf
+	r.valid = ok
+	r.cur = pair
+}
+
+// Key implements dbm.Iterator.
+func (r *readAheadIterator) Key() []byte { return r.cur.key }
+
+// Value implements dbm.Iterator.
+func (r *readAheadIterator) Value() []byte { return r.cur.value }
+
+// Close implements dbm.Iterator. It signals fill to stop and waits for it to
+// actually exit before closing the wrapped iterator, since most
+// dbm.Iterator implementations are not safe for concurrent use and fill may
+// otherwise still be mid-call against it.
+func (r *readAheadIterator) Close() {
+	close(r.done)
+	<-r.stopped
+	r.Iterator.Close()
+}
+
 // dbm.DB implements KVStore so we can CacheKVStore it.
 var _ types.KVStore = Store{}
@@ -0,0 +1,92 @@
+package dbadapter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func newTestStore() Store {
+	return Store{DB: dbm.NewMemDB()}
+}
+
+func TestBatchWriteCommitsBufferedWrites(t *testing.T) {
+	store := newTestStore()
+
+	b := store.WithBatchWriter()
+	b.Set([]byte("foo"), []byte("bar"))
+	b.Set([]byte("baz"), []byte("qux"))
+
+	require.NoError(t, b.Write())
+
+	require.Equal(t, []byte("bar"), store.Get([]byte("foo")))
+	require.Equal(t, []byte("qux"), store.Get([]byte("baz")))
+}
+
+func TestPrefixIterator(t *testing.T) {
+	store := newTestStore()
+
+	store.Set([]byte("acct/1"), []byte("v1"))
+	store.Set([]byte("acct/2"), []byte("v2"))
+	store.Set([]byte("acct/3"), []byte("v3"))
+	store.Set([]byte("other/1"), []byte("v4"))
+
+	it := store.PrefixIterator([]byte("acct/"), IteratorOptions{})
+	defer it.Close()
+
+	var keys []string
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+
+	require.Equal(t, []string{"acct/1", "acct/2", "acct/3"}, keys)
+}
+
+func TestPrefixIteratorWithReadAhead(t *testing.T) {
+	store := newTestStore()
+
+	store.Set([]byte("acct/1"), []byte("v1"))
+	store.Set([]byte("acct/2"), []byte("v2"))
+	store.Set([]byte("acct/3"), []byte("v3"))
+
+	it := store.PrefixIterator([]byte("acct/"), IteratorOptions{ReadAhead: 2})
+
+	var keys []string
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	it.Close()
+
+	require.Equal(t, []string{"acct/1", "acct/2", "acct/3"}, keys)
+}
+
+func TestPrefixIteratorReverse(t *testing.T) {
+	store := newTestStore()
+
+	store.Set([]byte("acct/1"), []byte("v1"))
+	store.Set([]byte("acct/2"), []byte("v2"))
+	store.Set([]byte("acct/3"), []byte("v3"))
+
+	it := store.PrefixIterator([]byte("acct/"), IteratorOptions{Reverse: true})
+	defer it.Close()
+
+	var keys []string
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+
+	require.Equal(t, []string{"acct/3", "acct/2", "acct/1"}, keys)
+}
+
+func TestReadAheadIteratorCloseStopsFillBeforeClosingWrapped(t *testing.T) {
+	store := newTestStore()
+	store.Set([]byte("acct/1"), []byte("v1"))
+	store.Set([]byte("acct/2"), []byte("v2"))
+
+	// Closing before the iterator is exhausted must not race with or panic
+	// the background fill goroutine closing the same underlying iterator.
+	it := store.PrefixIterator([]byte("acct/"), IteratorOptions{ReadAhead: 1})
+	it.Close()
+}
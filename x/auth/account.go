@@ -139,8 +139,8 @@ func (acc *BaseAccount) SetSequence(seq int64) error {
 
 var (
 	_ VestingAccount = (*ContinuousVestingAccount)(nil)
-	// TODO: uncomment once implemented
-	// _ VestingAccount = (*DelayedVestingAccount)(nil)
+	_ VestingAccount = (*DelayedVestingAccount)(nil)
+	_ VestingAccount = (*PeriodicVestingAccount)(nil)
 )
 
 type (
@@ -149,9 +149,10 @@ type (
 	BaseVestingAccount struct {
 		BaseAccount
 
-		originalVesting sdk.Coins // coins in account upon initialization
-		delegatedFree   sdk.Coins // coins that are vested and delegated
-		endTime         time.Time // when the coins become unlocked
+		originalVesting  sdk.Coins // coins in account upon initialization
+		delegatedFree    sdk.Coins // coins that are vested and delegated
+		delegatedVesting sdk.Coins // coins that are vesting and delegated
+		endTime          time.Time // when the coins become unlocked
 	}
 
 	// ContinuousVestingAccount implements the VestingAccount interface. It
@@ -159,8 +160,7 @@ type (
 	ContinuousVestingAccount struct {
 		BaseVestingAccount
 
-		delegatedVesting sdk.Coins // coins that vesting and delegated
-		startTime        time.Time // when the coins start to vest
+		startTime time.Time // when the coins start to vest
 	}
 
 	// DelayedVestingAccount implements the VestingAccount interface. It vests all
@@ -169,6 +169,25 @@ type (
 	DelayedVestingAccount struct {
 		BaseVestingAccount
 	}
+
+	// Period defines a length of time and the amount of coins that vest upon
+	// completion of that period. A period starts where the previous one ends
+	// (the first starts at the account's StartTime).
+	Period struct {
+		Length int64     `json:"length"` // length of the period, in seconds
+		Amount sdk.Coins `json:"amount"` // amount of coins vesting during the period
+	}
+
+	// PeriodicVestingAccount implements the VestingAccount interface. It vests
+	// tokens according to a periodic vesting schedule, where coins for a given
+	// period only vest once that period has fully elapsed. Periods do not vest
+	// linearly; a period that has only partially elapsed contributes nothing.
+	PeriodicVestingAccount struct {
+		BaseVestingAccount
+
+		StartTime      time.Time `json:"start_time"`
+		VestingPeriods []Period  `json:"vesting_periods"`
+	}
 )
 
 func NewContinuousVestingAccount(
@@ -192,50 +211,18 @@ func NewContinuousVestingAccount(
 	}
 }
 
-// GetVestedCoins returns the total number of vested coins. If no coins are vested,
-// nil is returned.
-func (cva ContinuousVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
-	var vestedCoins sdk.Coins
-
-	// We must handle the case where the start time for a vesting account has
-	// been set into the future or when the start of the chain is not exactly
-	// known.
-	if blockTime.Unix() <= cva.startTime.Unix() {
-		return vestedCoins
-	}
-
-	// calculate the vesting scalar
-	x := blockTime.Unix() - cva.startTime.Unix()
-	y := cva.endTime.Unix() - cva.startTime.Unix()
-	s := sdk.NewDec(x).Quo(sdk.NewDec(y))
-
-	for _, ovc := range cva.originalVesting {
-		vestedAmt := sdk.NewDecFromInt(ovc.Amount).Mul(s).RoundInt()
-		vestedCoin := sdk.NewCoin(ovc.Denom, vestedAmt)
-		vestedCoins = vestedCoins.Plus(sdk.Coins{vestedCoin})
-	}
-
-	return vestedCoins
-}
-
-// GetVestingCoins returns the total number of vesting coins. If no coins are
-// vesting, nil is returned.
-func (cva ContinuousVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
-	return cva.originalVesting.Minus(cva.GetVestedCoins(blockTime))
-}
-
-// SpendableCoins returns the total number of spendable coins per denom for a
-// continuous vesting account.
-func (cva ContinuousVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+// spendableCoins computes the total number of spendable coins per denom,
+// given the vesting coins for the current block time, per the specification:
+// min((BC + DV) - V, BC).
+func (bva BaseVestingAccount) spendableCoins(vestingCoins sdk.Coins) sdk.Coins {
 	var spendableCoins sdk.Coins
 
-	bc := cva.GetCoins()
-	v := cva.GetVestingCoins(blockTime)
+	bc := bva.GetCoins()
 
 	for _, coin := range bc {
 		baseAmt := coin.Amount
-		delVestingAmt := cva.delegatedVesting.AmountOf(coin.Denom)
-		vestingAmt := v.AmountOf(coin.Denom)
+		delVestingAmt := bva.delegatedVesting.AmountOf(coin.Denom)
+		vestingAmt := vestingCoins.AmountOf(coin.Denom)
 
 		// compute min((BC + DV) - V, BC) per the specification
 		min := sdk.MinInt(baseAmt.Add(delVestingAmt).Sub(vestingAmt), baseAmt)
@@ -249,12 +236,12 @@ func (cva ContinuousVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coin
 	return spendableCoins
 }
 
-// TrackDelegation tracks a desired delegation amount by setting the appropriate
-// values for the amount of delegated vesting, delegated free, and reducing the
-// overall amount of base coins.
-func (cva *ContinuousVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
-	bc := cva.GetCoins()
-	v := cva.GetVestingCoins(blockTime)
+// trackDelegation tracks a desired delegation amount by setting the
+// appropriate values for the amount of delegated vesting, delegated free, and
+// reducing the overall amount of base coins, given the vesting coins for the
+// current block time.
+func (bva *BaseVestingAccount) trackDelegation(vestingCoins, amount sdk.Coins) {
+	bc := bva.GetCoins()
 
 	for _, coin := range amount {
 		// Skip if the delegation amount is zero or if the base coins does not
@@ -263,8 +250,8 @@ func (cva *ContinuousVestingAccount) TrackDelegation(blockTime time.Time, amount
 			continue
 		}
 
-		vestingAmt := v.AmountOf(coin.Denom)
-		delVestingAmt := cva.delegatedVesting.AmountOf(coin.Denom)
+		vestingAmt := vestingCoins.AmountOf(coin.Denom)
+		delVestingAmt := bva.delegatedVesting.AmountOf(coin.Denom)
 
 		// compute x and y per the specification, where:
 		// X := min(max(V - DV, 0), D)
@@ -274,23 +261,23 @@ func (cva *ContinuousVestingAccount) TrackDelegation(blockTime time.Time, amount
 
 		if !x.IsZero() {
 			xCoin := sdk.NewCoin(coin.Denom, x)
-			cva.delegatedVesting = cva.delegatedVesting.Plus(sdk.Coins{xCoin})
+			bva.delegatedVesting = bva.delegatedVesting.Plus(sdk.Coins{xCoin})
 		}
 
 		if !y.IsZero() {
 			yCoin := sdk.NewCoin(coin.Denom, y)
-			cva.delegatedFree = cva.delegatedFree.Plus(sdk.Coins{yCoin})
+			bva.delegatedFree = bva.delegatedFree.Plus(sdk.Coins{yCoin})
 		}
 
-		cva.Coins = bc.Minus(sdk.Coins{coin})
+		bva.Coins = bc.Minus(sdk.Coins{coin})
 	}
 }
 
-// TrackUndelegation tracks an undelegation amount by setting the necessary
-// values by which delegated vesting and delegated vesting need to decrease and
+// trackUndelegation tracks an undelegation amount by setting the necessary
+// values by which delegated vesting and delegated free need to decrease and
 // by which amount the base coins need to increase.
-func (cva *ContinuousVestingAccount) TrackUndelegation(amount sdk.Coins) {
-	bc := cva.GetCoins()
+func (bva *BaseVestingAccount) trackUndelegation(amount sdk.Coins) {
+	bc := bva.GetCoins()
 
 	for _, coin := range amount {
 		// skip if the undelegation amount is zero
@@ -298,7 +285,7 @@ func (cva *ContinuousVestingAccount) TrackUndelegation(amount sdk.Coins) {
 			continue
 		}
 
-		delegatedFree := cva.delegatedFree.AmountOf(coin.Denom)
+		delegatedFree := bva.delegatedFree.AmountOf(coin.Denom)
 
 		// compute x and y per the specification, where:
 		// X := min(DF, D)
@@ -308,16 +295,211 @@ func (cva *ContinuousVestingAccount) TrackUndelegation(amount sdk.Coins) {
 
 		if !x.IsZero() {
 			xCoin := sdk.NewCoin(coin.Denom, x)
-			cva.delegatedFree = cva.delegatedFree.Minus(sdk.Coins{xCoin})
+			bva.delegatedFree = bva.delegatedFree.Minus(sdk.Coins{xCoin})
 		}
 
 		if !y.IsZero() {
 			yCoin := sdk.NewCoin(coin.Denom, y)
-			cva.delegatedVesting = cva.delegatedVesting.Minus(sdk.Coins{yCoin})
+			bva.delegatedVesting = bva.delegatedVesting.Minus(sdk.Coins{yCoin})
+		}
+
+		bva.Coins = bc.Plus(sdk.Coins{coin})
+	}
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (cva ContinuousVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	// We must handle the case where the start time for a vesting account has
+	// been set into the future or when the start of the chain is not exactly
+	// known.
+	if blockTime.Unix() <= cva.startTime.Unix() {
+		return vestedCoins
+	}
+
+	// calculate the vesting scalar
+	x := blockTime.Unix() - cva.startTime.Unix()
+	y := cva.endTime.Unix() - cva.startTime.Unix()
+	s := sdk.NewDec(x).Quo(sdk.NewDec(y))
+
+	for _, ovc := range cva.originalVesting {
+		vestedAmt := sdk.NewDecFromInt(ovc.Amount).Mul(s).RoundInt()
+		vestedCoin := sdk.NewCoin(ovc.Denom, vestedAmt)
+		vestedCoins = vestedCoins.Plus(sdk.Coins{vestedCoin})
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (cva ContinuousVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.originalVesting.Minus(cva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins per denom for a
+// continuous vesting account.
+func (cva ContinuousVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return cva.spendableCoins(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ContinuousVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	cva.trackDelegation(cva.GetVestingCoins(blockTime), amount)
+}
+
+// TrackUndelegation tracks an undelegation amount by setting the necessary
+// values by which delegated vesting and delegated vesting need to decrease and
+// by which amount the base coins need to increase.
+func (cva *ContinuousVestingAccount) TrackUndelegation(amount sdk.Coins) {
+	cva.trackUndelegation(amount)
+}
+
+//-----------------------------------------------------------------------------
+// DelayedVestingAccount
+
+func NewDelayedVestingAccount(
+	addr sdk.AccAddress, origCoins sdk.Coins, endTime time.Time,
+) *DelayedVestingAccount {
+
+	baseAcc := BaseAccount{
+		Address: addr,
+		Coins:   origCoins,
+	}
+
+	baseVestingAcc := BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		originalVesting: origCoins,
+		endTime:         endTime,
+	}
+
+	return &DelayedVestingAccount{BaseVestingAccount: baseVestingAcc}
+}
+
+// GetVestedCoins returns the total amount of vested coins for a delayed
+// vesting account. All coins are vested once blockTime reaches endTime; no
+// coins are vested prior.
+func (dva DelayedVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	if blockTime.Unix() >= dva.endTime.Unix() {
+		return dva.originalVesting
+	}
+
+	return nil
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (dva DelayedVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return dva.originalVesting.Minus(dva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins per denom for a
+// delayed vesting account.
+func (dva DelayedVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return dva.spendableCoins(dva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (dva *DelayedVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	dva.trackDelegation(dva.GetVestingCoins(blockTime), amount)
+}
+
+// TrackUndelegation tracks an undelegation amount by setting the necessary
+// values by which delegated vesting and delegated vesting need to decrease and
+// by which amount the base coins need to increase.
+func (dva *DelayedVestingAccount) TrackUndelegation(amount sdk.Coins) {
+	dva.trackUndelegation(amount)
+}
+
+//-----------------------------------------------------------------------------
+// PeriodicVestingAccount
+
+// NewPeriodicVestingAccount returns a new PeriodicVestingAccount. The account's
+// end time is derived from the start time plus the cumulative length of all
+// vesting periods.
+func NewPeriodicVestingAccount(
+	addr sdk.AccAddress, origCoins sdk.Coins, startTime time.Time, periods []Period,
+) *PeriodicVestingAccount {
+
+	endTime := startTime
+	for _, p := range periods {
+		endTime = endTime.Add(time.Duration(p.Length) * time.Second)
+	}
+
+	baseAcc := BaseAccount{
+		Address: addr,
+		Coins:   origCoins,
+	}
+
+	baseVestingAcc := BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		originalVesting: origCoins,
+		endTime:         endTime,
+	}
+
+	return &PeriodicVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+// GetVestedCoins returns the total amount of vested coins for a periodic
+// vesting account. Coins vest in discrete tranches: a period only contributes
+// its coins once it has fully elapsed, so a period that is only partially
+// reached contributes nothing (staggered, not linear, vesting).
+func (pva PeriodicVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	if blockTime.Unix() <= pva.StartTime.Unix() {
+		return vestedCoins
+	} else if blockTime.Unix() >= pva.endTime.Unix() {
+		return pva.originalVesting
+	}
+
+	periodEnd := pva.StartTime.Unix()
+	for _, period := range pva.VestingPeriods {
+		periodEnd += period.Length
+		if blockTime.Unix() < periodEnd {
+			break
 		}
 
-		cva.Coins = bc.Plus(sdk.Coins{coin})
+		vestedCoins = vestedCoins.Plus(period.Amount)
 	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (pva PeriodicVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return pva.originalVesting.Minus(pva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins per denom for a
+// periodic vesting account.
+func (pva PeriodicVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return pva.spendableCoins(pva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (pva *PeriodicVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	pva.trackDelegation(pva.GetVestingCoins(blockTime), amount)
+}
+
+// TrackUndelegation tracks an undelegation amount by setting the necessary
+// values by which delegated vesting and delegated vesting need to decrease and
+// by which amount the base coins need to increase.
+func (pva *PeriodicVestingAccount) TrackUndelegation(amount sdk.Coins) {
+	pva.trackUndelegation(amount)
 }
 
 //-----------------------------------------------------------------------------
@@ -330,5 +512,7 @@ func RegisterBaseAccount(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&BaseAccount{}, "cosmos-sdk/BaseAccount", nil)
 	cdc.RegisterConcrete(&ContinuousVestingAccount{}, "cosmos-sdk/ContinuousVestingAccount", nil)
 	cdc.RegisterConcrete(&DelayedVestingAccount{}, "cosmos-sdk/DelayedVestingAccount", nil)
+	cdc.RegisterConcrete(&PeriodicVestingAccount{}, "cosmos-sdk/PeriodicVestingAccount", nil)
+	cdc.RegisterConcrete(&ClawbackVestingAccount{}, "cosmos-sdk/ClawbackVestingAccount", nil)
 	codec.RegisterCrypto(cdc)
 }
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPeriodicVestingAccountGetVestedCoins(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	startTime := time.Unix(100, 0)
+	origCoins := sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(300))}
+
+	periods := []Period{
+		{Length: 10, Amount: sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}},
+		{Length: 10, Amount: sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}},
+		{Length: 10, Amount: sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}},
+	}
+
+	pva := NewPeriodicVestingAccount(addr, origCoins, startTime, periods)
+
+	tests := []struct {
+		name      string
+		blockTime time.Time
+		expected  sdk.Coins
+	}{
+		{"before start", time.Unix(100, 0), nil},
+		{"mid first period vests nothing", time.Unix(105, 0), nil},
+		{"first period complete", time.Unix(110, 0), sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}},
+		{"mid second period still only first vests", time.Unix(115, 0), sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}},
+		{"first two periods complete", time.Unix(120, 0), sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(200))}},
+		{"after end everything vests", time.Unix(1000, 0), origCoins},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.True(t, tt.expected.IsEqual(pva.GetVestedCoins(tt.blockTime)), "expected %s, got %s", tt.expected, pva.GetVestedCoins(tt.blockTime))
+		})
+	}
+}
+
+func TestDelayedVestingAccountGetVestedCoins(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr2_______________"))
+	origCoins := sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}
+	endTime := time.Unix(200, 0)
+
+	dva := NewDelayedVestingAccount(addr, origCoins, endTime)
+
+	require.Nil(t, dva.GetVestedCoins(time.Unix(199, 0)))
+	require.True(t, origCoins.IsEqual(dva.GetVestedCoins(time.Unix(200, 0))))
+	require.True(t, origCoins.IsEqual(dva.GetVestedCoins(time.Unix(201, 0))))
+}
@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ VestingAccount = (*ClawbackVestingAccount)(nil)
+
+// ClawbackVestingAccount is a PeriodicVestingAccount that additionally grants
+// FunderAddress the ability to claw back any coins that have not yet vested.
+// Coins that are delegated and still vesting at the time of a clawback
+// cannot be moved immediately since they are bonded; they are earmarked in
+// DelegatedVestingToReturn and forwarded to the funder as they unbond via the
+// staking unbonding hook in Hooks.
+type ClawbackVestingAccount struct {
+	PeriodicVestingAccount
+
+	FunderAddress            sdk.AccAddress `json:"funder_address"`
+	IsClawedBack             bool           `json:"is_clawed_back"`
+	DelegatedVestingToReturn sdk.Coins      `json:"delegated_vesting_to_return"`
+}
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount funded by
+// funderAddr.
+func NewClawbackVestingAccount(
+	addr, funderAddr sdk.AccAddress, origCoins sdk.Coins, startTime time.Time, periods []Period,
+) *ClawbackVestingAccount {
+
+	pva := NewPeriodicVestingAccount(addr, origCoins, startTime, periods)
+
+	return &ClawbackVestingAccount{
+		PeriodicVestingAccount: *pva,
+		FunderAddress:          funderAddr,
+	}
+}
+
+// SpendableCoins returns the total number of spendable coins per denom. Once
+// a clawback has been processed the account no longer carries a vesting
+// restriction: its entire remaining balance is spendable.
+func (cva ClawbackVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	if cva.IsClawedBack {
+		return cva.GetCoins()
+	}
+
+	return cva.PeriodicVestingAccount.SpendableCoins(blockTime)
+}
+
+// Clawback computes the coins still owed to the funder as of blockTime —
+// originalVesting minus what has already vested, minus whatever of that is
+// currently delegated and therefore illiquid — and marks the account as
+// clawed back. It returns the portion that can be returned to the funder
+// immediately; the rest is earmarked in DelegatedVestingToReturn for Hooks to
+// forward once it unbonds.
+//
+// Clawback does not itself debit cva.Coins: the caller is expected to move
+// the returned amount via the bank keeper's SendCoins, which is the sole
+// debit of the account's balance (mirroring how
+// x/validator-vesting's handler funds a new account).
+func (cva *ClawbackVestingAccount) Clawback(blockTime time.Time) sdk.Coins {
+	unvested := cva.originalVesting.Minus(cva.GetVestedCoins(blockTime))
+
+	var stillBonded sdk.Coins
+	for _, coin := range unvested {
+		bonded := sdk.MinInt(cva.delegatedVesting.AmountOf(coin.Denom), coin.Amount)
+		if !bonded.IsZero() {
+			stillBonded = stillBonded.Plus(sdk.Coins{sdk.NewCoin(coin.Denom, bonded)})
+		}
+	}
+
+	immediate := unvested.Minus(stillBonded)
+
+	cva.IsClawedBack = true
+	cva.DelegatedVestingToReturn = cva.DelegatedVestingToReturn.Plus(stillBonded)
+
+	return immediate
+}
+
+// TrackDelegation tracks a desired delegation amount. Once a clawback has
+// been processed the account behaves like a plain BaseAccount: there is no
+// longer a vesting schedule to restrict delegation against, so the full
+// balance is treated as free. TrackUndelegation needs no equivalent override
+// since the promoted implementation never consults the vesting schedule.
+func (cva *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	if cva.IsClawedBack {
+		cva.trackDelegation(nil, amount)
+		return
+	}
+
+	cva.PeriodicVestingAccount.TrackDelegation(blockTime, amount)
+}
+
+// DivertUndelegation splits a matured unbonding delegation amount between
+// whatever is still earmarked for the funder in DelegatedVestingToReturn and
+// the remainder, which is left for the caller to credit back to the account
+// as usual. It returns the portion owed to the funder.
+func (cva *ClawbackVestingAccount) DivertUndelegation(amount sdk.Coins) sdk.Coins {
+	var toFunder sdk.Coins
+
+	for _, coin := range amount {
+		owed := cva.DelegatedVestingToReturn.AmountOf(coin.Denom)
+		if owed.IsZero() {
+			continue
+		}
+
+		diverted := sdk.MinInt(owed, coin.Amount)
+		divertedCoin := sdk.NewCoin(coin.Denom, diverted)
+
+		toFunder = toFunder.Plus(sdk.Coins{divertedCoin})
+		cva.DelegatedVestingToReturn = cva.DelegatedVestingToReturn.Minus(sdk.Coins{divertedCoin})
+	}
+
+	return toFunder
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestClawbackVestingAccountClawback(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	funder := sdk.AccAddress([]byte("funder______________"))
+	startTime := time.Unix(100, 0)
+	origCoins := sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(300))}
+
+	periods := []Period{
+		{Length: 10, Amount: sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}},
+		{Length: 10, Amount: sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}},
+		{Length: 10, Amount: sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}},
+	}
+
+	cva := NewClawbackVestingAccount(addr, funder, origCoins, startTime, periods)
+
+	// Delegate 50 of the still-vesting balance at t=110 (first period just
+	// vested, 200 still unvested).
+	cva.TrackDelegation(time.Unix(110, 0), sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(50))})
+	require.True(t, sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(50))}.IsEqual(cva.delegatedVesting))
+
+	// Claw back at t=110: 200 unvested, 50 of it delegated (still bonded) so
+	// only 150 can move immediately; the delegated 50 is earmarked instead.
+	immediate := cva.Clawback(time.Unix(110, 0))
+
+	require.True(t, sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(150))}.IsEqual(immediate))
+	require.True(t, cva.IsClawedBack)
+	require.True(t, sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(50))}.IsEqual(cva.DelegatedVestingToReturn))
+
+	// Clawback itself must not debit cva.Coins: the caller's SendCoins is
+	// the sole debit. The balance is still the 250 left after the delegation
+	// of 50 above.
+	require.True(t, sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(250))}.IsEqual(cva.GetCoins()))
+
+	// Once clawed back, a further delegation is treated as entirely free
+	// rather than restricted against the (now irrelevant) vesting schedule:
+	// delegatedVesting is untouched and the new amount lands in
+	// delegatedFree.
+	cva.TrackDelegation(time.Unix(120, 0), sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(250))})
+	require.True(t, sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(50))}.IsEqual(cva.delegatedVesting))
+	require.True(t, sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(250))}.IsEqual(cva.delegatedFree))
+}
+
+func TestClawbackVestingAccountDivertUndelegation(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	funder := sdk.AccAddress([]byte("funder______________"))
+
+	cva := NewClawbackVestingAccount(addr, funder, sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}, time.Unix(0, 0), nil)
+	cva.DelegatedVestingToReturn = sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(30))}
+
+	toFunder := cva.DivertUndelegation(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(50))})
+
+	require.True(t, sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(30))}.IsEqual(toFunder))
+	require.True(t, cva.DelegatedVestingToReturn.IsZero())
+}
@@ -0,0 +1,16 @@
+package auth
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the concrete Msg types defined by this module.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgClawback{}, "cosmos-sdk/MsgClawback", nil)
+}
+
+var moduleCdc = codec.New()
+
+func init() {
+	RegisterCodec(moduleCdc)
+}
@@ -0,0 +1,286 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the expected account keeper used to simplify genesis
+// initialization and export, so that the genesis package is not coupled to
+// the concrete keeper implementation.
+type AccountKeeper interface {
+	NewAccount(sdk.Context, Account) Account
+	GetAccount(sdk.Context, sdk.AccAddress) Account
+	SetAccount(sdk.Context, Account)
+	IterateAccounts(sdk.Context, func(Account) bool)
+}
+
+// GenesisAccount is a genesis-friendly projection of an Account that can carry
+// any of the account/vesting variants. Only the fields relevant to the
+// account's concrete type are populated.
+type GenesisAccount struct {
+	Address       sdk.AccAddress `json:"address"`
+	Coins         sdk.Coins      `json:"coins"`
+	Sequence      int64          `json:"sequence_number"`
+	AccountNumber int64          `json:"account_number"`
+
+	// vesting account fields
+	OriginalVesting  sdk.Coins `json:"original_vesting"`  // total vesting coins upon initialization
+	DelegatedFree    sdk.Coins `json:"delegated_free"`    // delegated vested coins at time of delegation
+	DelegatedVesting sdk.Coins `json:"delegated_vesting"` // delegated vesting coins at time of delegation
+	StartTime        int64     `json:"start_time"`        // vesting start time (continuous, periodic)
+	EndTime          int64     `json:"end_time"`          // vesting end time
+
+	// periodic vesting account fields
+	VestingPeriods []Period `json:"vesting_periods,omitempty"`
+
+	// clawback vesting account fields
+	FunderAddress            sdk.AccAddress `json:"funder_address,omitempty"`
+	IsClawedBack             bool           `json:"is_clawed_back,omitempty"`
+	DelegatedVestingToReturn sdk.Coins      `json:"delegated_vesting_to_return,omitempty"`
+
+	// ExtensionType and Extension carry genesis data for an account type
+	// defined outside the auth package (e.g. x/validator-vesting's
+	// ValidatorVestingAccount). NewGenesisAccountI and ToAccount can't name
+	// such a type directly in a type switch, since a package built on top of
+	// auth importing back into auth would be a cycle; instead the owning
+	// package registers a GenesisAccountConverter that round-trips its type
+	// through these two fields.
+	ExtensionType string          `json:"extension_type,omitempty"`
+	Extension     json.RawMessage `json:"extension,omitempty"`
+}
+
+// GenesisAccountConverter lets a package built on top of auth plug its own
+// Account implementation into genesis export/import. Register one with
+// RegisterGenesisAccountConverter from the owning package's init.
+type GenesisAccountConverter struct {
+	// ExtensionType names the account type this converter handles. It is
+	// stored in GenesisAccount.ExtensionType so ToAccount knows which
+	// registered converter's Restore to call.
+	ExtensionType string
+	// ToGenesisAccount converts acc to a GenesisAccount, or returns
+	// ok == false if acc is not the type this converter handles.
+	ToGenesisAccount func(acc Account) (gacc GenesisAccount, ok bool)
+	// Restore reconstructs an Account from a GenesisAccount previously
+	// produced by ToGenesisAccount. gacc is passed as stored:
+	// gacc.ExtensionType still names this converter and gacc.Extension holds
+	// the data that function set.
+	Restore func(gacc GenesisAccount) Account
+}
+
+var genesisAccountConverters = make(map[string]GenesisAccountConverter)
+
+// RegisterGenesisAccountConverter registers conv under conv.ExtensionType.
+func RegisterGenesisAccountConverter(conv GenesisAccountConverter) {
+	genesisAccountConverters[conv.ExtensionType] = conv
+}
+
+// NewGenesisAccount creates a GenesisAccount from a BaseAccount.
+func NewGenesisAccount(acc *BaseAccount) GenesisAccount {
+	return GenesisAccount{
+		Address:       acc.Address,
+		Coins:         acc.Coins,
+		AccountNumber: acc.AccountNumber,
+		Sequence:      acc.Sequence,
+	}
+}
+
+// NewGenesisAccountI creates a GenesisAccount from any Account variant,
+// preserving the extra fields needed by the vesting account implementations.
+func NewGenesisAccountI(acc Account) (GenesisAccount, error) {
+	gacc := GenesisAccount{
+		Address:       acc.GetAddress(),
+		Coins:         acc.GetCoins(),
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      acc.GetSequence(),
+	}
+
+	switch t := acc.(type) {
+	case *ContinuousVestingAccount:
+		gacc.OriginalVesting = t.originalVesting
+		gacc.DelegatedFree = t.delegatedFree
+		gacc.DelegatedVesting = t.delegatedVesting
+		gacc.StartTime = t.startTime.Unix()
+		gacc.EndTime = t.endTime.Unix()
+
+	case *DelayedVestingAccount:
+		gacc.OriginalVesting = t.originalVesting
+		gacc.DelegatedFree = t.delegatedFree
+		gacc.DelegatedVesting = t.delegatedVesting
+		gacc.EndTime = t.endTime.Unix()
+
+	case *PeriodicVestingAccount:
+		gacc.OriginalVesting = t.originalVesting
+		gacc.DelegatedFree = t.delegatedFree
+		gacc.DelegatedVesting = t.delegatedVesting
+		gacc.StartTime = t.StartTime.Unix()
+		gacc.EndTime = t.endTime.Unix()
+		gacc.VestingPeriods = t.VestingPeriods
+
+	case *ClawbackVestingAccount:
+		gacc.OriginalVesting = t.originalVesting
+		gacc.DelegatedFree = t.delegatedFree
+		gacc.DelegatedVesting = t.delegatedVesting
+		gacc.StartTime = t.StartTime.Unix()
+		gacc.EndTime = t.endTime.Unix()
+		gacc.VestingPeriods = t.VestingPeriods
+		gacc.FunderAddress = t.FunderAddress
+		gacc.IsClawedBack = t.IsClawedBack
+		gacc.DelegatedVestingToReturn = t.DelegatedVestingToReturn
+
+	case *BaseAccount:
+		// no additional fields
+
+	default:
+		for _, conv := range genesisAccountConverters {
+			if converted, ok := conv.ToGenesisAccount(acc); ok {
+				return converted, nil
+			}
+		}
+		return GenesisAccount{}, fmt.Errorf("unrecognized account type: %T", acc)
+	}
+
+	return gacc, nil
+}
+
+// ToAccount converts a GenesisAccount to an Account, reconstructing whichever
+// vesting variant the populated fields describe.
+func (ga *GenesisAccount) ToAccount() Account {
+	if ga.ExtensionType != "" {
+		conv, ok := genesisAccountConverters[ga.ExtensionType]
+		if !ok {
+			panic(fmt.Sprintf("auth: no genesis account converter registered for extension type %q", ga.ExtensionType))
+		}
+		return conv.Restore(*ga)
+	}
+
+	baseAcc := &BaseAccount{
+		Address:       ga.Address,
+		Coins:         ga.Coins,
+		AccountNumber: ga.AccountNumber,
+		Sequence:      ga.Sequence,
+	}
+
+	if ga.OriginalVesting == nil {
+		return baseAcc
+	}
+
+	baseVestingAcc := BaseVestingAccount{
+		BaseAccount:      *baseAcc,
+		originalVesting:  ga.OriginalVesting,
+		delegatedFree:    ga.DelegatedFree,
+		delegatedVesting: ga.DelegatedVesting,
+		endTime:          time.Unix(ga.EndTime, 0),
+	}
+
+	switch {
+	case ga.FunderAddress != nil:
+		return &ClawbackVestingAccount{
+			PeriodicVestingAccount: PeriodicVestingAccount{
+				BaseVestingAccount: baseVestingAcc,
+				StartTime:          time.Unix(ga.StartTime, 0),
+				VestingPeriods:     ga.VestingPeriods,
+			},
+			FunderAddress:            ga.FunderAddress,
+			IsClawedBack:             ga.IsClawedBack,
+			DelegatedVestingToReturn: ga.DelegatedVestingToReturn,
+		}
+
+	case len(ga.VestingPeriods) > 0:
+		return &PeriodicVestingAccount{
+			BaseVestingAccount: baseVestingAcc,
+			StartTime:          time.Unix(ga.StartTime, 0),
+			VestingPeriods:     ga.VestingPeriods,
+		}
+
+	case ga.StartTime > 0:
+		return &ContinuousVestingAccount{
+			BaseVestingAccount: baseVestingAcc,
+			startTime:          time.Unix(ga.StartTime, 0),
+		}
+
+	default:
+		return &DelayedVestingAccount{BaseVestingAccount: baseVestingAcc}
+	}
+}
+
+// GenesisState is the auth module's genesis state, consisting of the set of
+// accounts present at genesis.
+type GenesisState struct {
+	Accounts []GenesisAccount `json:"accounts"`
+}
+
+// NewGenesisState returns a new GenesisState.
+func NewGenesisState(accounts []GenesisAccount) GenesisState {
+	return GenesisState{Accounts: accounts}
+}
+
+// DefaultGenesisState returns an empty GenesisState.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]GenesisAccount{})
+}
+
+// InitGenesis initializes accounts in the auth module's keeper from genesis
+// state.
+func InitGenesis(ctx sdk.Context, ak AccountKeeper, data GenesisState) {
+	for _, ga := range data.Accounts {
+		acc := ga.ToAccount()
+		acc = ak.NewAccount(ctx, acc)
+		ak.SetAccount(ctx, acc)
+	}
+}
+
+// ExportGenesis returns a GenesisState containing every account currently
+// held by the auth module's keeper.
+func ExportGenesis(ctx sdk.Context, ak AccountKeeper) GenesisState {
+	var accounts []GenesisAccount
+
+	ak.IterateAccounts(ctx, func(acc Account) bool {
+		gacc, err := NewGenesisAccountI(acc)
+		if err != nil {
+			panic(err)
+		}
+
+		accounts = append(accounts, gacc)
+		return false
+	})
+
+	return NewGenesisState(accounts)
+}
+
+// ValidateGenesis checks that the accounts in the genesis state are
+// well-formed, in particular that every vesting account satisfies the
+// invariants required of it: the sum of its period amounts (where
+// applicable) equals its original vesting, and every period has a strictly
+// positive length.
+func ValidateGenesis(data GenesisState) error {
+	for _, ga := range data.Accounts {
+		if len(ga.VestingPeriods) == 0 {
+			continue
+		}
+
+		var summedPeriods sdk.Coins
+		for _, p := range ga.VestingPeriods {
+			if p.Length <= 0 {
+				return fmt.Errorf(
+					"vesting period for account %s must have a strictly positive length, got %d",
+					ga.Address, p.Length,
+				)
+			}
+
+			summedPeriods = summedPeriods.Plus(p.Amount)
+		}
+
+		if !summedPeriods.IsEqual(ga.OriginalVesting) {
+			return fmt.Errorf(
+				"vesting periods for account %s do not sum to original vesting: %s != %s",
+				ga.Address, summedPeriods, ga.OriginalVesting,
+			)
+		}
+	}
+
+	return nil
+}
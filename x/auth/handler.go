@@ -0,0 +1,54 @@
+package auth
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper defines the expected bank keeper used to move coins returned by
+// a clawback back to the funder.
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+}
+
+// NewHandler returns a handler for all auth module messages.
+func NewHandler(ak AccountKeeper, bk BankKeeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgClawback:
+			return handleMsgClawback(ctx, ak, bk, msg)
+		default:
+			return sdk.ErrUnknownRequest("unrecognized auth message type").Result()
+		}
+	}
+}
+
+func handleMsgClawback(ctx sdk.Context, ak AccountKeeper, bk BankKeeper, msg MsgClawback) sdk.Result {
+	acc := ak.GetAccount(ctx, msg.AccountAddress)
+	if acc == nil {
+		return sdk.ErrUnknownRequest("account does not exist").Result()
+	}
+
+	cva, ok := acc.(*ClawbackVestingAccount)
+	if !ok {
+		return sdk.ErrUnknownRequest("account is not a clawback vesting account").Result()
+	}
+
+	if !msg.FunderAddress.Equals(cva.FunderAddress) {
+		return sdk.ErrUnauthorized("only the funder may claw back this account").Result()
+	}
+
+	if cva.IsClawedBack {
+		return sdk.ErrUnknownRequest("account has already been clawed back").Result()
+	}
+
+	immediate := cva.Clawback(ctx.BlockHeader().Time)
+	ak.SetAccount(ctx, cva)
+
+	if !immediate.IsZero() {
+		if err := bk.SendCoins(ctx, msg.AccountAddress, msg.FunderAddress, immediate); err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{}
+}
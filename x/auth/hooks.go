@@ -0,0 +1,42 @@
+package auth
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Hooks implements the staking module's expected hooks interface so that,
+// once an unbonding delegation matures, any portion of it earmarked on a
+// ClawbackVestingAccount is routed back to the account's funder instead of
+// being credited to the delegator.
+type Hooks struct {
+	ak AccountKeeper
+	bk BankKeeper
+}
+
+// NewHooks returns a new Hooks.
+func NewHooks(ak AccountKeeper, bk BankKeeper) Hooks {
+	return Hooks{ak: ak, bk: bk}
+}
+
+// AfterUnbondingDelegationMatures is called by the staking module once a
+// delegator's unbonding delegation has completed and amount is about to be
+// credited back to delAddr.
+func (h Hooks) AfterUnbondingDelegationMatures(ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.Coins) {
+	acc := h.ak.GetAccount(ctx, delAddr)
+
+	cva, ok := acc.(*ClawbackVestingAccount)
+	if !ok || cva.DelegatedVestingToReturn.IsZero() {
+		return
+	}
+
+	toFunder := cva.DivertUndelegation(amount)
+	if toFunder.IsZero() {
+		return
+	}
+
+	h.ak.SetAccount(ctx, cva)
+
+	if err := h.bk.SendCoins(ctx, delAddr, cva.FunderAddress, toFunder); err != nil {
+		return
+	}
+}
@@ -0,0 +1,48 @@
+package auth
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgClawback is submitted by a ClawbackVestingAccount's funder to return any
+// coins that have not yet vested.
+type MsgClawback struct {
+	FunderAddress  sdk.AccAddress `json:"funder_address"`
+	AccountAddress sdk.AccAddress `json:"account_address"`
+}
+
+// NewMsgClawback returns a new MsgClawback.
+func NewMsgClawback(funderAddr, accountAddr sdk.AccAddress) MsgClawback {
+	return MsgClawback{
+		FunderAddress:  funderAddr,
+		AccountAddress: accountAddr,
+	}
+}
+
+// Route implements sdk.Msg.
+func (msg MsgClawback) Route() string { return "auth" }
+
+// Type implements sdk.Msg.
+func (msg MsgClawback) Type() string { return "clawback" }
+
+// ValidateBasic implements sdk.Msg.
+func (msg MsgClawback) ValidateBasic() sdk.Error {
+	if msg.FunderAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing funder address")
+	}
+	if msg.AccountAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing account address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FunderAddress}
+}
@@ -4,41 +4,57 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-
-	params "github.com/cosmos/cosmos-sdk/x/params/space"
 )
 
-// EndBlock returns consensus parameters set in the block
-func EndBlock(ctx sdk.Context, space params.Space) (updates *abci.ConsensusParams) {
+// EndBlock returns the abci.ConsensusParams fields that have changed since
+// the previous block, so that governance-driven parameter changes reach
+// Tendermint as soon as they are applied rather than only at genesis.
+func EndBlock(ctx sdk.Context, k Keeper) (updates *abci.ConsensusParams) {
+	current := k.GetParams(ctx)
+	last, hadLast := k.getLastParams(ctx)
+
+	defer k.setLastParams(ctx, current)
+
+	if !hadLast {
+		last = current
+	}
+
 	updates = &abci.ConsensusParams{
 		BlockSize:   new(abci.BlockSize),
 		TxSize:      new(abci.TxSize),
 		BlockGossip: new(abci.BlockGossip),
 	}
 
-	if space.Modified(ctx, blockMaxBytesKey) {
-		space.Get(ctx, blockMaxBytesKey, &updates.BlockSize.MaxBytes)
-	}
+	changed := false
 
-	if space.Modified(ctx, blockMaxTxsKey) {
-		space.Get(ctx, blockMaxTxsKey, &updates.BlockSize.MaxTxs)
+	if !hadLast || current.BlockMaxBytes != last.BlockMaxBytes {
+		updates.BlockSize.MaxBytes = current.BlockMaxBytes
+		changed = true
 	}
-
-	if space.Modified(ctx, blockMaxGasKey) {
-		space.Get(ctx, blockMaxGasKey, &updates.BlockSize.MaxGas)
+	if !hadLast || current.BlockMaxTxs != last.BlockMaxTxs {
+		updates.BlockSize.MaxTxs = current.BlockMaxTxs
+		changed = true
 	}
-
-	if space.Modified(ctx, txMaxBytesKey) {
-		space.Get(ctx, txMaxBytesKey, &updates.TxSize.MaxBytes)
+	if !hadLast || current.BlockMaxGas != last.BlockMaxGas {
+		updates.BlockSize.MaxGas = current.BlockMaxGas
+		changed = true
 	}
-
-	if space.Modified(ctx, txMaxGasKey) {
-		space.Get(ctx, txMaxGasKey, &updates.TxSize.MaxGas)
+	if !hadLast || current.TxMaxBytes != last.TxMaxBytes {
+		updates.TxSize.MaxBytes = current.TxMaxBytes
+		changed = true
+	}
+	if !hadLast || current.TxMaxGas != last.TxMaxGas {
+		updates.TxSize.MaxGas = current.TxMaxGas
+		changed = true
+	}
+	if !hadLast || current.BlockPartSizeBytes != last.BlockPartSizeBytes {
+		updates.BlockGossip.BlockPartSizeBytes = current.BlockPartSizeBytes
+		changed = true
 	}
 
-	if space.Modified(ctx, blockPartSizeBytesKey) {
-		space.Get(ctx, blockPartSizeBytesKey, &updates.BlockGossip.BlockPartSizeBytes)
+	if !changed {
+		return nil
 	}
 
 	return
-}
\ No newline at end of file
+}
@@ -0,0 +1,37 @@
+package consensus
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState is the consensus module's genesis state.
+type GenesisState struct {
+	Params Params `json:"params"`
+}
+
+// NewGenesisState returns a new GenesisState.
+func NewGenesisState(params Params) GenesisState {
+	return GenesisState{Params: params}
+}
+
+// DefaultGenesisState returns a GenesisState with the default consensus
+// parameters.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams())
+}
+
+// ValidateGenesis checks that the genesis parameters satisfy the same bounds
+// enforced on governance-driven changes.
+func ValidateGenesis(data GenesisState) error {
+	return data.Params.ValidateBasic()
+}
+
+// InitGenesis sets the consensus parameters from genesis state.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	k.SetParams(ctx, data.Params)
+}
+
+// ExportGenesis returns the current consensus parameters as GenesisState.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return NewGenesisState(k.GetParams(ctx))
+}
@@ -0,0 +1,28 @@
+package consensus
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// NewProposalHandler returns a governance proposal handler for
+// ConsensusParamsChangeProposal content.
+func NewProposalHandler(k Keeper) gov.Handler {
+	return func(ctx sdk.Context, content gov.Content) sdk.Error {
+		switch c := content.(type) {
+		case ConsensusParamsChangeProposal:
+			return handleConsensusParamsChangeProposal(ctx, k, c)
+		default:
+			return sdk.ErrUnknownRequest("unrecognized consensus proposal content type")
+		}
+	}
+}
+
+func handleConsensusParamsChangeProposal(ctx sdk.Context, k Keeper, p ConsensusParamsChangeProposal) sdk.Error {
+	if err := p.Changes.ValidateBasic(); err != nil {
+		return sdk.ErrUnknownRequest(err.Error())
+	}
+
+	k.SetParams(ctx, p.Changes)
+	return nil
+}
@@ -0,0 +1,72 @@
+package consensus
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	params "github.com/cosmos/cosmos-sdk/x/params/space"
+)
+
+// Keeper manages the consensus module's on-chain parameters and tracks the
+// last set of values propagated to Tendermint, so EndBlock can diff against
+// them and only emit fields that actually changed this block.
+type Keeper struct {
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
+	space    params.Space
+}
+
+// NewKeeper constructs a new consensus Keeper.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, space params.Space) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+		space:    space,
+	}
+}
+
+// GetParams returns the current consensus parameters.
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	var p Params
+
+	k.space.Get(ctx, blockMaxBytesKey, &p.BlockMaxBytes)
+	k.space.Get(ctx, blockMaxTxsKey, &p.BlockMaxTxs)
+	k.space.Get(ctx, blockMaxGasKey, &p.BlockMaxGas)
+	k.space.Get(ctx, txMaxBytesKey, &p.TxMaxBytes)
+	k.space.Get(ctx, txMaxGasKey, &p.TxMaxGas)
+	k.space.Get(ctx, blockPartSizeBytesKey, &p.BlockPartSizeBytes)
+
+	return p
+}
+
+// SetParams atomically writes every consensus parameter key.
+func (k Keeper) SetParams(ctx sdk.Context, p Params) {
+	k.space.Set(ctx, blockMaxBytesKey, p.BlockMaxBytes)
+	k.space.Set(ctx, blockMaxTxsKey, p.BlockMaxTxs)
+	k.space.Set(ctx, blockMaxGasKey, p.BlockMaxGas)
+	k.space.Set(ctx, txMaxBytesKey, p.TxMaxBytes)
+	k.space.Set(ctx, txMaxGasKey, p.TxMaxGas)
+	k.space.Set(ctx, blockPartSizeBytesKey, p.BlockPartSizeBytes)
+}
+
+var lastParamsKey = []byte("lastParams")
+
+// getLastParams returns the parameters as of the end of the previous block,
+// so EndBlock can compute which fields changed this block.
+func (k Keeper) getLastParams(ctx sdk.Context) (Params, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(lastParamsKey)
+	if bz == nil {
+		return Params{}, false
+	}
+
+	var p Params
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &p)
+	return p, true
+}
+
+// setLastParams records p as the parameters propagated as of this block.
+func (k Keeper) setLastParams(ctx sdk.Context, p Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(lastParamsKey, k.cdc.MustMarshalBinaryLengthPrefixed(p))
+}
@@ -0,0 +1,11 @@
+package consensus
+
+// Parameter store keys. Each key maps to a single field of Params.
+var (
+	blockMaxBytesKey      = []byte("blockMaxBytes")
+	blockMaxTxsKey        = []byte("blockMaxTxs")
+	blockMaxGasKey        = []byte("blockMaxGas")
+	txMaxBytesKey         = []byte("txMaxBytes")
+	txMaxGasKey           = []byte("txMaxGas")
+	blockPartSizeBytesKey = []byte("blockPartSizeBytes")
+)
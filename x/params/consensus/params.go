@@ -0,0 +1,64 @@
+package consensus
+
+import (
+	"fmt"
+)
+
+// Params groups together every consensus parameter that can be tuned through
+// governance, mirroring the fields exposed via abci.ConsensusParams.
+type Params struct {
+	BlockMaxBytes      int64 `json:"block_max_bytes"`
+	BlockMaxTxs        int64 `json:"block_max_txs"`
+	BlockMaxGas        int64 `json:"block_max_gas"`
+	TxMaxBytes         int64 `json:"tx_max_bytes"`
+	TxMaxGas           int64 `json:"tx_max_gas"`
+	BlockPartSizeBytes int64 `json:"block_part_size_bytes"`
+}
+
+// DefaultParams returns the consensus parameters used if none are set at
+// genesis.
+func DefaultParams() Params {
+	return Params{
+		BlockMaxBytes:      22020096,
+		BlockMaxTxs:        10000,
+		BlockMaxGas:        -1,
+		TxMaxBytes:         2097152,
+		TxMaxGas:           -1,
+		BlockPartSizeBytes: 65536,
+	}
+}
+
+// ValidateBasic performs stateless validation of the consensus parameters,
+// enforcing the same bounds Tendermint itself places on them.
+func (p Params) ValidateBasic() error {
+	if p.BlockMaxBytes <= 0 {
+		return fmt.Errorf("block max bytes must be positive: %d", p.BlockMaxBytes)
+	}
+	if p.BlockMaxTxs <= 0 {
+		return fmt.Errorf("block max txs must be positive: %d", p.BlockMaxTxs)
+	}
+	if p.BlockMaxGas < -1 {
+		return fmt.Errorf("block max gas must be -1 or greater: %d", p.BlockMaxGas)
+	}
+	if p.TxMaxBytes <= 0 {
+		return fmt.Errorf("tx max bytes must be positive: %d", p.TxMaxBytes)
+	}
+	if p.TxMaxGas < -1 {
+		return fmt.Errorf("tx max gas must be -1 or greater: %d", p.TxMaxGas)
+	}
+	if p.BlockPartSizeBytes <= 0 || !isPowerOfTwo(p.BlockPartSizeBytes) {
+		return fmt.Errorf("block part size bytes must be a power of two: %d", p.BlockPartSizeBytes)
+	}
+	if p.BlockPartSizeBytes > p.BlockMaxBytes {
+		return fmt.Errorf(
+			"block part size bytes (%d) must not exceed block max bytes (%d)",
+			p.BlockPartSizeBytes, p.BlockMaxBytes,
+		)
+	}
+
+	return nil
+}
+
+func isPowerOfTwo(n int64) bool {
+	return n > 0 && n&(n-1) == 0
+}
@@ -0,0 +1,43 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidateBasic(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  Params
+		wantErr bool
+	}{
+		{"default params are valid", DefaultParams(), false},
+		{"zero block max bytes", Params{BlockMaxBytes: 0, BlockMaxTxs: 1, BlockMaxGas: -1, TxMaxBytes: 1, TxMaxGas: -1, BlockPartSizeBytes: 1}, true},
+		{"zero block max txs", Params{BlockMaxBytes: 1, BlockMaxTxs: 0, BlockMaxGas: -1, TxMaxBytes: 1, TxMaxGas: -1, BlockPartSizeBytes: 1}, true},
+		{"block max gas below -1", Params{BlockMaxBytes: 1, BlockMaxTxs: 1, BlockMaxGas: -2, TxMaxBytes: 1, TxMaxGas: -1, BlockPartSizeBytes: 1}, true},
+		{"tx max gas below -1", Params{BlockMaxBytes: 1, BlockMaxTxs: 1, BlockMaxGas: -1, TxMaxBytes: 1, TxMaxGas: -2, BlockPartSizeBytes: 1}, true},
+		{"block part size not a power of two", Params{BlockMaxBytes: 100, BlockMaxTxs: 1, BlockMaxGas: -1, TxMaxBytes: 1, TxMaxGas: -1, BlockPartSizeBytes: 3}, true},
+		{"block part size exceeds block max bytes", Params{BlockMaxBytes: 64, BlockMaxTxs: 1, BlockMaxGas: -1, TxMaxBytes: 1, TxMaxGas: -1, BlockPartSizeBytes: 128}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.ValidateBasic()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsPowerOfTwo(t *testing.T) {
+	require.True(t, isPowerOfTwo(1))
+	require.True(t, isPowerOfTwo(2))
+	require.True(t, isPowerOfTwo(65536))
+	require.False(t, isPowerOfTwo(0))
+	require.False(t, isPowerOfTwo(-2))
+	require.False(t, isPowerOfTwo(3))
+}
@@ -0,0 +1,64 @@
+package consensus
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// RouterKey is the message route for the consensus module, used to route
+// governance proposals to NewProposalHandler.
+const RouterKey = "consensus"
+
+// ProposalTypeChange defines the proposal type for a
+// ConsensusParamsChangeProposal.
+const ProposalTypeChange = "ConsensusParamsChange"
+
+func init() {
+	gov.RegisterProposalType(ProposalTypeChange)
+	gov.RegisterProposalTypeCodec(ConsensusParamsChangeProposal{}, "cosmos-sdk/ConsensusParamsChangeProposal")
+}
+
+// ConsensusParamsChangeProposal is a governance proposal that updates the
+// chain's consensus parameters on-chain.
+type ConsensusParamsChangeProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Changes     Params `json:"changes"`
+}
+
+// NewConsensusParamsChangeProposal returns a new
+// ConsensusParamsChangeProposal.
+func NewConsensusParamsChangeProposal(title, description string, changes Params) ConsensusParamsChangeProposal {
+	return ConsensusParamsChangeProposal{
+		Title:       title,
+		Description: description,
+		Changes:     changes,
+	}
+}
+
+// GetTitle implements gov.Content.
+func (cp ConsensusParamsChangeProposal) GetTitle() string { return cp.Title }
+
+// GetDescription implements gov.Content.
+func (cp ConsensusParamsChangeProposal) GetDescription() string { return cp.Description }
+
+// ProposalRoute implements gov.Content.
+func (cp ConsensusParamsChangeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements gov.Content.
+func (cp ConsensusParamsChangeProposal) ProposalType() string { return ProposalTypeChange }
+
+// ValidateBasic implements gov.Content.
+func (cp ConsensusParamsChangeProposal) ValidateBasic() error {
+	return cp.Changes.ValidateBasic()
+}
+
+// String implements fmt.Stringer.
+func (cp ConsensusParamsChangeProposal) String() string {
+	return fmt.Sprintf(`Consensus Params Change Proposal:
+  Title:       %s
+  Description: %s
+  Changes:     %+v
+`, cp.Title, cp.Description, cp.Changes)
+}
@@ -0,0 +1,32 @@
+package consensus
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QueryParams is the path for querying the current consensus parameters.
+const QueryParams = "params"
+
+// NewQuerier returns the querier for the consensus module.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryParams:
+			return queryParams(ctx, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown consensus query endpoint")
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,145 @@
+package validatorvesting
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+var _ auth.VestingAccount = (*ValidatorVestingAccount)(nil)
+
+type (
+	// Progress tracks whether a vesting period, once it has elapsed, completed
+	// with enough blocks signed for its coins to vest, or failed and was
+	// clawed back.
+	Progress struct {
+		PeriodComplete    bool `json:"period_complete"`
+		VestingSuccessful bool `json:"vesting_successful"`
+	}
+
+	// SigningProgress tracks how many of the blocks proposed so far in the
+	// current vesting period the validator has signed.
+	SigningProgress struct {
+		MissedBlocks int64 `json:"missed_blocks"`
+		TotalBlocks  int64 `json:"total_blocks"`
+	}
+
+	// ValidatorVestingAccount is a PeriodicVestingAccount whose periods only
+	// vest if the bonded validator identified by ValidatorAddress signed at
+	// least SigningThreshold percent of the blocks proposed during that
+	// period. Periods that fail the threshold are clawed back to
+	// ReturnAddress (or burned, if unset) instead of vesting.
+	ValidatorVestingAccount struct {
+		*auth.PeriodicVestingAccount
+
+		ValidatorAddress      sdk.ConsAddress `json:"validator_address"`
+		ReturnAddress         sdk.AccAddress  `json:"return_address"`
+		SigningThreshold      int64           `json:"signing_threshold"`
+		CurrentPeriodProgress SigningProgress `json:"current_period_progress"`
+		VestingPeriodProgress []Progress      `json:"vesting_period_progress"`
+	}
+)
+
+// NewValidatorVestingAccount returns a new ValidatorVestingAccount wrapping a
+// PeriodicVestingAccount built from baseAccount's address and coins,
+// startTime, and periods.
+func NewValidatorVestingAccount(
+	baseAccount *auth.BaseAccount, startTime int64, periods []auth.Period,
+	valAddr sdk.ConsAddress, returnAddr sdk.AccAddress, signingThreshold int64,
+) *ValidatorVestingAccount {
+
+	pva := auth.NewPeriodicVestingAccount(
+		baseAccount.GetAddress(), baseAccount.GetCoins(), secondsToTime(startTime), periods,
+	)
+
+	progress := make([]Progress, len(periods))
+
+	return &ValidatorVestingAccount{
+		PeriodicVestingAccount: pva,
+		ValidatorAddress:       valAddr,
+		ReturnAddress:          returnAddr,
+		SigningThreshold:       signingThreshold,
+		VestingPeriodProgress:  progress,
+	}
+}
+
+func secondsToTime(sec int64) time.Time {
+	return time.Unix(sec, 0)
+}
+
+// genesisExtensionType identifies ValidatorVestingAccount to the auth
+// module's genesis account converter registry.
+const genesisExtensionType = "validator-vesting/ValidatorVestingAccount"
+
+// genesisExtension is the validator-vesting-specific data threaded through
+// auth.GenesisAccount's Extension field for a ValidatorVestingAccount; the
+// embedded PeriodicVestingAccount's own fields travel through the regular
+// GenesisAccount fields auth already knows how to (de)serialize.
+type genesisExtension struct {
+	ValidatorAddress      sdk.ConsAddress `json:"validator_address"`
+	ReturnAddress         sdk.AccAddress  `json:"return_address"`
+	SigningThreshold      int64           `json:"signing_threshold"`
+	CurrentPeriodProgress SigningProgress `json:"current_period_progress"`
+	VestingPeriodProgress []Progress      `json:"vesting_period_progress"`
+}
+
+func init() {
+	auth.RegisterGenesisAccountConverter(auth.GenesisAccountConverter{
+		ExtensionType:    genesisExtensionType,
+		ToGenesisAccount: vvaToGenesisAccount,
+		Restore:          vvaFromGenesisAccount,
+	})
+}
+
+func vvaToGenesisAccount(acc auth.Account) (auth.GenesisAccount, bool) {
+	vva, ok := acc.(*ValidatorVestingAccount)
+	if !ok {
+		return auth.GenesisAccount{}, false
+	}
+
+	gacc, err := auth.NewGenesisAccountI(vva.PeriodicVestingAccount)
+	if err != nil {
+		panic(err)
+	}
+
+	bz, err := json.Marshal(genesisExtension{
+		ValidatorAddress:      vva.ValidatorAddress,
+		ReturnAddress:         vva.ReturnAddress,
+		SigningThreshold:      vva.SigningThreshold,
+		CurrentPeriodProgress: vva.CurrentPeriodProgress,
+		VestingPeriodProgress: vva.VestingPeriodProgress,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	gacc.ExtensionType = genesisExtensionType
+	gacc.Extension = bz
+
+	return gacc, true
+}
+
+func vvaFromGenesisAccount(gacc auth.GenesisAccount) auth.Account {
+	var ext genesisExtension
+	if err := json.Unmarshal(gacc.Extension, &ext); err != nil {
+		panic(err)
+	}
+
+	// Clear the extension before delegating back to auth's own
+	// reconstruction logic, so it rebuilds the embedded
+	// PeriodicVestingAccount instead of recursing into this converter.
+	gacc.ExtensionType = ""
+	gacc.Extension = nil
+	pva := gacc.ToAccount().(*auth.PeriodicVestingAccount)
+
+	return &ValidatorVestingAccount{
+		PeriodicVestingAccount: pva,
+		ValidatorAddress:       ext.ValidatorAddress,
+		ReturnAddress:          ext.ReturnAddress,
+		SigningThreshold:       ext.SigningThreshold,
+		CurrentPeriodProgress:  ext.CurrentPeriodProgress,
+		VestingPeriodProgress:  ext.VestingPeriodProgress,
+	}
+}
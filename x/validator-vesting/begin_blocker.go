@@ -0,0 +1,120 @@
+package validatorvesting
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker updates the signing progress of every known validator vesting
+// account from the votes included in the current block, and settles any
+// vesting period that has just elapsed: periods that met their signing
+// threshold vest normally, while periods that did not are clawed back.
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
+	blockTime := ctx.BlockHeader().Time.Unix()
+
+	signed := make(map[string]bool, len(req.LastCommitInfo.GetVotes()))
+	for _, vote := range req.LastCommitInfo.GetVotes() {
+		addr := sdk.ConsAddress(vote.Validator.Address)
+		signed[addr.String()] = vote.SignedLastBlock
+	}
+
+	k.IterateAccountAddresses(ctx, func(addr sdk.AccAddress) bool {
+		vva, ok := k.GetAccountFromAuthKeeper(ctx, addr)
+		if !ok {
+			return false
+		}
+
+		vva.CurrentPeriodProgress.TotalBlocks++
+		if !signed[vva.ValidatorAddress.String()] {
+			vva.CurrentPeriodProgress.MissedBlocks++
+		}
+
+		settleElapsedPeriods(ctx, k, vva, blockTime)
+
+		k.SetAccount(ctx, vva)
+		return false
+	})
+
+	k.SetPreviousBlockTime(ctx, blockTime)
+}
+
+// settleElapsedPeriods settles every vesting period that has fully elapsed
+// as of blockTime but has not yet been settled: clawing back a period's
+// coins if the signing threshold was not met over its span, leaving them to
+// vest normally via the underlying periodic schedule otherwise. A single
+// call may settle more than one period if several period boundaries fell
+// within the same inter-block gap.
+func settleElapsedPeriods(ctx sdk.Context, k Keeper, vva *ValidatorVestingAccount, blockTime int64) {
+	for {
+		periodIndex := firstIncompletePeriod(vva)
+		if periodIndex < 0 || periodIndex >= len(vva.VestingPeriods) {
+			return
+		}
+
+		periodEnd := periodEndUnix(vva, periodIndex)
+		if blockTime < periodEnd {
+			return
+		}
+
+		vva.VestingPeriodProgress[periodIndex].PeriodComplete = true
+
+		signedPct := int64(100)
+		if vva.CurrentPeriodProgress.TotalBlocks > 0 {
+			signedBlocks := vva.CurrentPeriodProgress.TotalBlocks - vva.CurrentPeriodProgress.MissedBlocks
+			signedPct = signedBlocks * 100 / vva.CurrentPeriodProgress.TotalBlocks
+		}
+
+		if signedPct >= vva.SigningThreshold {
+			vva.VestingPeriodProgress[periodIndex].VestingSuccessful = true
+		} else {
+			clawbackPeriod(ctx, k, vva, periodIndex)
+		}
+
+		vva.CurrentPeriodProgress = SigningProgress{}
+	}
+}
+
+// firstIncompletePeriod returns the index of the first period that has not
+// yet been marked complete, or len(vva.VestingPeriodProgress) if every
+// period has settled.
+func firstIncompletePeriod(vva *ValidatorVestingAccount) int {
+	for i, p := range vva.VestingPeriodProgress {
+		if !p.PeriodComplete {
+			return i
+		}
+	}
+	return len(vva.VestingPeriodProgress)
+}
+
+// periodEndUnix returns the unix time at which the period at idx elapses.
+func periodEndUnix(vva *ValidatorVestingAccount, idx int) int64 {
+	end := vva.StartTime.Unix()
+	for i := 0; i <= idx; i++ {
+		end += vva.VestingPeriods[i].Length
+	}
+	return end
+}
+
+// clawbackPeriod zeroes out the failed period's scheduled amount, so it never
+// vests, and sends the equivalent coins held in the account to ReturnAddress,
+// or burns them by removing them from the account's balance if unset.
+//
+// vva.Coins is decremented in-memory in both branches so that the
+// SetAccount call BeginBlocker makes right after settling this account
+// persists a balance consistent with what SendCoins just wrote to the
+// store; otherwise that SetAccount would overwrite the sender's
+// just-debited balance with the stale, pre-clawback one.
+func clawbackPeriod(ctx sdk.Context, k Keeper, vva *ValidatorVestingAccount, periodIndex int) {
+	clawbackAmt := vva.VestingPeriods[periodIndex].Amount
+	vva.VestingPeriods[periodIndex].Amount = sdk.Coins{}
+	vva.Coins = vva.Coins.Minus(clawbackAmt)
+
+	if vva.ReturnAddress.Empty() {
+		return
+	}
+
+	// SendCoins debits vva's balance directly; any error (e.g. an already
+	// clawed-back balance) is not fatal to BeginBlock processing.
+	_ = k.bk.SendCoins(ctx, vva.GetAddress(), vva.ReturnAddress, clawbackAmt)
+}
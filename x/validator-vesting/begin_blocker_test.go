@@ -0,0 +1,88 @@
+package validatorvesting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// fakeBankKeeper records every SendCoins call it receives.
+type fakeBankKeeper struct {
+	sent []sdk.Coins
+}
+
+func (fbk *fakeBankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	fbk.sent = append(fbk.sent, amt)
+	return nil
+}
+
+func newTestAccount(signingThreshold int64) *ValidatorVestingAccount {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	baseAcc := &auth.BaseAccount{Address: addr}
+
+	periods := []auth.Period{
+		{Length: 10, Amount: sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}},
+		{Length: 10, Amount: sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}},
+	}
+	baseAcc.Coins = sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(200))}
+
+	return NewValidatorVestingAccount(baseAcc, 100, periods, nil, nil, signingThreshold)
+}
+
+func TestSettleElapsedPeriodsSettlesEveryElapsedPeriod(t *testing.T) {
+	var ctx sdk.Context
+	k := Keeper{bk: &fakeBankKeeper{}}
+
+	vva := newTestAccount(1)
+
+	// Both period boundaries (t=110 and t=120) have elapsed by the time this
+	// is called. Previously only one period was settled per call, leaving
+	// the second stuck forever since PeriodComplete never flipped for it.
+	settleElapsedPeriods(ctx, k, vva, 120)
+
+	require.True(t, vva.VestingPeriodProgress[0].PeriodComplete)
+	require.True(t, vva.VestingPeriodProgress[1].PeriodComplete)
+}
+
+func TestSettleElapsedPeriodsStopsAtUnelapsedPeriod(t *testing.T) {
+	var ctx sdk.Context
+	k := Keeper{bk: &fakeBankKeeper{}}
+
+	vva := newTestAccount(80)
+	vva.CurrentPeriodProgress = SigningProgress{TotalBlocks: 10, MissedBlocks: 0}
+
+	// Only the first period boundary (t=110) has elapsed.
+	settleElapsedPeriods(ctx, k, vva, 115)
+
+	require.True(t, vva.VestingPeriodProgress[0].PeriodComplete)
+	require.True(t, vva.VestingPeriodProgress[0].VestingSuccessful)
+	require.False(t, vva.VestingPeriodProgress[1].PeriodComplete)
+}
+
+func TestSettleElapsedPeriodsClawsBackFailedPeriod(t *testing.T) {
+	var ctx sdk.Context
+	bk := &fakeBankKeeper{}
+	k := Keeper{bk: bk}
+
+	vva := newTestAccount(80)
+	vva.ReturnAddress = sdk.AccAddress([]byte("returnaddr__________"))
+	vva.CurrentPeriodProgress = SigningProgress{TotalBlocks: 10, MissedBlocks: 10}
+
+	// Only the first period boundary (t=110) has elapsed, and it failed the
+	// signing threshold (0% signed).
+	settleElapsedPeriods(ctx, k, vva, 110)
+
+	require.True(t, vva.VestingPeriodProgress[0].PeriodComplete)
+	require.False(t, vva.VestingPeriodProgress[0].VestingSuccessful)
+
+	// The failed period's scheduled amount no longer vests and has been
+	// debited from the account's balance in memory, consistent with what
+	// SendCoins already did to the store.
+	require.True(t, vva.VestingPeriods[0].Amount.IsZero())
+	require.True(t, sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}.IsEqual(vva.Coins))
+	require.Len(t, bk.sent, 1)
+	require.True(t, sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}.IsEqual(bk.sent[0]))
+}
@@ -0,0 +1,18 @@
+package validatorvesting
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the concrete types and interfaces needed by the
+// validator-vesting module.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateValidatorVestingAccount{}, "validator-vesting/MsgCreateValidatorVestingAccount", nil)
+	cdc.RegisterConcrete(&ValidatorVestingAccount{}, "cosmos-sdk/ValidatorVestingAccount", nil)
+}
+
+var moduleCdc = codec.New()
+
+func init() {
+	RegisterCodec(moduleCdc)
+}
@@ -0,0 +1,32 @@
+package validatorvesting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultCodespace is the codespace for all errors defined in this module.
+const DefaultCodespace sdk.CodespaceType = "validator-vesting"
+
+// Error codes for the validator-vesting module.
+const (
+	CodeInvalidSigningThreshold sdk.CodeType = 101
+	CodeAccountNotFound         sdk.CodeType = 102
+	CodeNotValidatorVestingAcc  sdk.CodeType = 103
+)
+
+// ErrInvalidSigningThreshold returns an error for a signing threshold outside
+// the inclusive (0, 100] percent range.
+func ErrInvalidSigningThreshold(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSigningThreshold, "signing threshold must be between 1 and 100 percent")
+}
+
+// ErrAccountNotFound returns an error for a missing account.
+func ErrAccountNotFound(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeAccountNotFound, "account %s does not exist", addr)
+}
+
+// ErrNotValidatorVestingAccount returns an error when the account at the
+// given address is not a ValidatorVestingAccount.
+func ErrNotValidatorVestingAccount(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNotValidatorVestingAcc, "account %s is not a validator vesting account", addr)
+}
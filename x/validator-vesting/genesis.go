@@ -0,0 +1,34 @@
+package validatorvesting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState is the validator-vesting module's genesis state. The vesting
+// accounts themselves are exported as part of the auth module's genesis
+// state; this only captures the module's own block-time bookkeeping.
+type GenesisState struct {
+	PreviousBlockTime int64 `json:"previous_block_time"`
+}
+
+// NewGenesisState returns a new GenesisState.
+func NewGenesisState(previousBlockTime int64) GenesisState {
+	return GenesisState{PreviousBlockTime: previousBlockTime}
+}
+
+// DefaultGenesisState returns a GenesisState with no recorded previous block
+// time.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(0)
+}
+
+// InitGenesis sets the module's previous block time from genesis state.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	k.SetPreviousBlockTime(ctx, data.PreviousBlockTime)
+}
+
+// ExportGenesis returns the module's current previous block time as
+// GenesisState.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return NewGenesisState(k.GetPreviousBlockTime(ctx))
+}
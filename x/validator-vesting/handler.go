@@ -0,0 +1,49 @@
+package validatorvesting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// NewHandler returns a handler for all validator-vesting module messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgCreateValidatorVestingAccount:
+			return handleMsgCreateValidatorVestingAccount(ctx, k, msg)
+		default:
+			errMsg := "unrecognized validator-vesting message type"
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgCreateValidatorVestingAccount(
+	ctx sdk.Context, k Keeper, msg MsgCreateValidatorVestingAccount,
+) sdk.Result {
+
+	if _, exists := k.GetAccountFromAuthKeeper(ctx, msg.ToAddress); exists {
+		return sdk.ErrUnknownRequest("a validator vesting account already exists at this address").Result()
+	}
+
+	baseAccount := auth.NewBaseAccountWithAddress(msg.ToAddress)
+	if err := baseAccount.SetCoins(msg.Amount); err != nil {
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+
+	vva := NewValidatorVestingAccount(
+		&baseAccount, msg.StartTime, msg.VestingPeriods,
+		msg.ValidatorAddress, msg.ReturnAddress, msg.SigningThreshold,
+	)
+
+	// the account is created with its full balance already set; route the
+	// funding through the bank keeper so the sender's balance is debited.
+	vva.Coins = sdk.Coins{}
+	k.SetAccount(ctx, vva)
+
+	if err := k.bk.SendCoins(ctx, msg.FromAddress, msg.ToAddress, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{}
+}
@@ -0,0 +1,109 @@
+package validatorvesting
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// AccountKeeper defines the subset of the auth keeper's behaviour this module
+// depends on.
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) auth.Account
+	SetAccount(ctx sdk.Context, acc auth.Account)
+}
+
+// BankKeeper defines the subset of the bank keeper's behaviour this module
+// depends on in order to fund a newly created validator vesting account.
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+}
+
+// Keeper manages validator vesting accounts and the module's own record of
+// the previous block time.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *codec.Codec
+	ak       AccountKeeper
+	bk       BankKeeper
+}
+
+// NewKeeper constructs a new validator-vesting Keeper.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, ak AccountKeeper, bk BankKeeper) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+		ak:       ak,
+		bk:       bk,
+	}
+}
+
+// GetAccountFromAuthKeeper looks up the account at addr through the auth
+// keeper and returns it as a ValidatorVestingAccount, or false if no such
+// account exists or it is not a ValidatorVestingAccount.
+func (k Keeper) GetAccountFromAuthKeeper(ctx sdk.Context, addr sdk.AccAddress) (*ValidatorVestingAccount, bool) {
+	acc := k.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, false
+	}
+
+	vva, ok := acc.(*ValidatorVestingAccount)
+	return vva, ok
+}
+
+// SetAccount persists a ValidatorVestingAccount through the auth keeper and
+// indexes its address so BeginBlock can find it.
+func (k Keeper) SetAccount(ctx sdk.Context, vva *ValidatorVestingAccount) {
+	k.ak.SetAccount(ctx, vva)
+	k.indexAccount(ctx, vva.GetAddress())
+}
+
+var (
+	previousBlockTimeKey = []byte("previousBlockTime")
+	accountIndexPrefix   = []byte("accountIndex")
+)
+
+// indexAccount records addr so future BeginBlock calls know to track it.
+func (k Keeper) indexAccount(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(append(accountIndexPrefix, addr.Bytes()...), addr.Bytes())
+}
+
+// IterateAccountAddresses iterates over every address that has been indexed
+// as a validator vesting account.
+func (k Keeper) IterateAccountAddresses(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, accountIndexPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		addr := sdk.AccAddress(iter.Value())
+		if cb(addr) {
+			break
+		}
+	}
+}
+
+// GetPreviousBlockTime returns the unix timestamp of the previous block, or
+// zero if this is the first block this keeper has seen.
+func (k Keeper) GetPreviousBlockTime(ctx sdk.Context) int64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(previousBlockTimeKey)
+	if bz == nil {
+		return 0
+	}
+
+	var t int64
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &t)
+	return t
+}
+
+// SetPreviousBlockTime stores the unix timestamp of the current block. It is
+// kept for genesis export; BeginBlocker detects vesting period rollovers by
+// comparing the block time with each period's end, not by reading this value.
+func (k Keeper) SetPreviousBlockTime(ctx sdk.Context, t int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(previousBlockTimeKey, k.cdc.MustMarshalBinaryLengthPrefixed(t))
+}
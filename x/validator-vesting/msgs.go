@@ -0,0 +1,86 @@
+package validatorvesting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// MsgCreateValidatorVestingAccount funds a new ValidatorVestingAccount at
+// ToAddress from FromAddress, vesting according to VestingPeriods and subject
+// to clawback if ValidatorAddress fails to sign SigningThreshold percent of
+// blocks during a period.
+type MsgCreateValidatorVestingAccount struct {
+	FromAddress      sdk.AccAddress  `json:"from_address"`
+	ToAddress        sdk.AccAddress  `json:"to_address"`
+	Amount           sdk.Coins       `json:"amount"`
+	StartTime        int64           `json:"start_time"`
+	VestingPeriods   []auth.Period   `json:"vesting_periods"`
+	ValidatorAddress sdk.ConsAddress `json:"validator_address"`
+	SigningThreshold int64           `json:"signing_threshold"`
+	ReturnAddress    sdk.AccAddress  `json:"return_address"`
+}
+
+// NewMsgCreateValidatorVestingAccount returns a new
+// MsgCreateValidatorVestingAccount.
+func NewMsgCreateValidatorVestingAccount(
+	fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, startTime int64,
+	periods []auth.Period, valAddr sdk.ConsAddress, signingThreshold int64, returnAddr sdk.AccAddress,
+) MsgCreateValidatorVestingAccount {
+
+	return MsgCreateValidatorVestingAccount{
+		FromAddress:      fromAddr,
+		ToAddress:        toAddr,
+		Amount:           amount,
+		StartTime:        startTime,
+		VestingPeriods:   periods,
+		ValidatorAddress: valAddr,
+		SigningThreshold: signingThreshold,
+		ReturnAddress:    returnAddr,
+	}
+}
+
+// Route implements sdk.Msg.
+func (msg MsgCreateValidatorVestingAccount) Route() string { return "validator-vesting" }
+
+// Type implements sdk.Msg.
+func (msg MsgCreateValidatorVestingAccount) Type() string { return "create_validator_vesting_account" }
+
+// ValidateBasic implements sdk.Msg.
+func (msg MsgCreateValidatorVestingAccount) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing from address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing to address")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdk.ErrInvalidCoins("amount must be positive")
+	}
+	if msg.SigningThreshold <= 0 || msg.SigningThreshold > 100 {
+		return ErrInvalidSigningThreshold(DefaultCodespace)
+	}
+
+	var summedPeriods sdk.Coins
+	for _, p := range msg.VestingPeriods {
+		if p.Length <= 0 {
+			return sdk.ErrUnknownRequest("vesting period length must be strictly positive")
+		}
+		summedPeriods = summedPeriods.Plus(p.Amount)
+	}
+
+	if !summedPeriods.IsEqual(msg.Amount) {
+		return sdk.ErrUnknownRequest("sum of vesting periods must equal amount")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgCreateValidatorVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg.
+func (msg MsgCreateValidatorVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
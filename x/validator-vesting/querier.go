@@ -0,0 +1,49 @@
+package validatorvesting
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// QueryAccount is the path for querying a single validator vesting
+	// account.
+	QueryAccount = "account"
+)
+
+// NewQuerier returns the querier for the validator-vesting module.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryAccount:
+			return queryAccount(ctx, path[1:], k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown validator-vesting query endpoint")
+		}
+	}
+}
+
+func queryAccount(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) != 1 {
+		return nil, sdk.ErrUnknownRequest("expected account address in query path")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(path[0])
+	}
+
+	vva, ok := k.GetAccountFromAuthKeeper(ctx, addr)
+	if !ok {
+		return nil, ErrAccountNotFound(DefaultCodespace, addr)
+	}
+
+	bz, marshalErr := codec.MarshalJSONIndent(k.cdc, vva)
+	if marshalErr != nil {
+		return nil, sdk.ErrInternal(marshalErr.Error())
+	}
+
+	return bz, nil
+}